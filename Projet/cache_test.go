@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestMain(m *testing.M) {
+	logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	m.Run()
+}
+
+func TestLRUCacheEvictsOldestEntry(t *testing.T) {
+	cache := newLRUCache(2)
+
+	cache.put("a", cacheEntry{URL: "a"})
+	cache.put("b", cacheEntry{URL: "b"})
+	cache.put("c", cacheEntry{URL: "c"})
+
+	if _, ok := cache.get("a"); ok {
+		t.Error("expected \"a\" to have been evicted as the oldest entry")
+	}
+	if _, ok := cache.get("b"); !ok {
+		t.Error("expected \"b\" to still be cached")
+	}
+	if _, ok := cache.get("c"); !ok {
+		t.Error("expected \"c\" to still be cached")
+	}
+}
+
+func TestLRUCacheGetPromotesToFront(t *testing.T) {
+	cache := newLRUCache(2)
+
+	cache.put("a", cacheEntry{URL: "a"})
+	cache.put("b", cacheEntry{URL: "b"})
+
+	// Accéder à "a" la remonte en tête; "b" devient la plus ancienne.
+	if _, ok := cache.get("a"); !ok {
+		t.Fatal("expected \"a\" to be cached")
+	}
+	cache.put("c", cacheEntry{URL: "c"})
+
+	if _, ok := cache.get("b"); ok {
+		t.Error("expected \"b\" to have been evicted after \"a\" was promoted")
+	}
+	if _, ok := cache.get("a"); !ok {
+		t.Error("expected \"a\" to still be cached")
+	}
+}
+
+func newTestAPIClient(t *testing.T) *APIClient {
+	t.Helper()
+	return &APIClient{
+		httpClient:   &http.Client{Timeout: 5 * time.Second},
+		l1:           newLRUCache(DefaultLRUSize),
+		diskCacheDir: t.TempDir(),
+		cacheTTL:     time.Minute,
+	}
+}
+
+func TestFetchAndStoreCachesETag(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	client := newTestAPIClient(t)
+	entry, err := client.fetchAndStore(context.Background(), server.URL, cacheEntry{})
+	if err != nil {
+		t.Fatalf("fetchAndStore: %v", err)
+	}
+	if string(entry.Data) != "hello" {
+		t.Errorf("entry.Data = %q, want %q", entry.Data, "hello")
+	}
+	if entry.ETag != `"v1"` {
+		t.Errorf("entry.ETag = %q, want %q", entry.ETag, `"v1"`)
+	}
+}
+
+func TestFetchAndStoreRevalidatesOn304(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("hello"))
+	}))
+	defer server.Close()
+
+	client := newTestAPIClient(t)
+	previous := cacheEntry{
+		URL:       server.URL,
+		Data:      []byte("hello"),
+		ETag:      `"v1"`,
+		FetchedAt: time.Now().Add(-time.Hour),
+	}
+
+	refreshed, err := client.fetchAndStore(context.Background(), server.URL, previous)
+	if err != nil {
+		t.Fatalf("fetchAndStore: %v", err)
+	}
+	if string(refreshed.Data) != "hello" {
+		t.Errorf("refreshed.Data = %q, want %q (kept from previous entry)", refreshed.Data, "hello")
+	}
+	if !refreshed.FetchedAt.After(previous.FetchedAt) {
+		t.Error("expected FetchedAt to be bumped on a 304 revalidation")
+	}
+	if got := client.metrics.Revalidations; got != 1 {
+		t.Errorf("metrics.Revalidations = %d, want 1", got)
+	}
+	if got := client.metrics.BytesSaved; got != int64(len("hello")) {
+		t.Errorf("metrics.BytesSaved = %d, want %d", got, len("hello"))
+	}
+}
@@ -1,15 +1,31 @@
 package main
 
 import (
+	"container/list"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"flag"
 	"fmt"
 	"html/template"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
+
+	"golang.org/x/sync/errgroup"
 )
 
 // ============================================================================
@@ -17,13 +33,154 @@ import (
 // ============================================================================
 
 const (
-	BaseAPIURL        = "https://groupietrackers.herokuapp.com/api"
-	ArtistsEndpoint   = BaseAPIURL + "/artists"
-	LocationsEndpoint = BaseAPIURL + "/locations"
-	DatesEndpoint     = BaseAPIURL + "/dates"
-	RelationEndpoint  = BaseAPIURL + "/relation"
+	// Chemins des ressources, relatifs à la base URL configurée de l'API amont.
+	artistsPath   = "/artists"
+	locationsPath = "/locations"
+	datesPath     = "/dates"
+	relationPath  = "/relation"
+
+	// ShutdownTimeout borne le temps laissé au serveur pour vider les
+	// requêtes en vol lors d'un arrêt gracieux.
+	ShutdownTimeout = 10 * time.Second
 )
 
+// ============================================================================
+// CONFIGURATION
+// ============================================================================
+
+// Config regroupe les paramètres d'exécution, résolus depuis les flags de
+// ligne de commande puis, à défaut, les variables d'environnement.
+type Config struct {
+	Port         string
+	APIBaseURL   string
+	CacheTTL     time.Duration
+	CacheLRUSize int
+	HTTPTimeout  time.Duration
+	TemplatesDir string
+	StaticDir    string
+	LogLevel     string
+	LogFormat    string
+}
+
+// envOrDefault renvoie la variable d'environnement key si elle est définie,
+// sinon fallback.
+func envOrDefault(key, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// loadConfig construit la Config à partir des flags de ligne de commande,
+// chacun pré-rempli par sa variable d'environnement correspondante.
+func loadConfig() *Config {
+	cfg := &Config{}
+
+	flag.StringVar(&cfg.Port, "port", envOrDefault("PORT", "8080"), "port d'écoute du serveur HTTP")
+	flag.StringVar(&cfg.APIBaseURL, "api-base-url", envOrDefault("API_BASE_URL", "https://groupietrackers.herokuapp.com/api"), "URL de base de l'API Groupie Tracker amont")
+	flag.DurationVar(&cfg.CacheTTL, "cache-ttl", envDuration("CACHE_TTL", 5*time.Minute), "durée de fraîcheur des entrées du cache")
+	flag.IntVar(&cfg.CacheLRUSize, "cache-lru-size", envInt("CACHE_LRU_SIZE", DefaultLRUSize), "nombre d'entrées conservées dans le cache L1 (LRU en mémoire)")
+	flag.DurationVar(&cfg.HTTPTimeout, "http-timeout", envDuration("HTTP_TIMEOUT", 10*time.Second), "délai maximal des requêtes vers l'API amont")
+	flag.StringVar(&cfg.TemplatesDir, "templates-dir", envOrDefault("TEMPLATES_DIR", "./templates"), "répertoire des templates HTML")
+	flag.StringVar(&cfg.StaticDir, "static-dir", envOrDefault("STATIC_DIR", "./static"), "répertoire des fichiers statiques")
+	flag.StringVar(&cfg.LogLevel, "log-level", envOrDefault("LOG_LEVEL", "info"), "niveau de log (debug, info, warn, error)")
+	flag.StringVar(&cfg.LogFormat, "log-format", envOrDefault("LOG_FORMAT", "json"), "format de log (json, text)")
+
+	flag.Parse()
+
+	return cfg
+}
+
+// envDuration lit une variable d'environnement comme une time.Duration
+// (ex: "5m", "10s"), et renvoie fallback si elle est absente ou invalide.
+func envDuration(key string, fallback time.Duration) time.Duration {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	parsed, err := time.ParseDuration(raw)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+// envInt lit une variable d'environnement comme un entier, et renvoie
+// fallback si elle est absente ou invalide.
+func envInt(key string, fallback int) int {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback
+	}
+	parsed, err := strconv.Atoi(raw)
+	if err != nil {
+		return fallback
+	}
+	return parsed
+}
+
+// ============================================================================
+// LOGGING STRUCTURÉ
+// ============================================================================
+
+// logger est le logger racine de l'application, configuré par newLogger
+// d'après la Config (niveau et format). Les handlers HTTP utilisent plutôt
+// le logger par requête porté par le contexte, voir loggerFromContext.
+var logger *slog.Logger
+
+// parseLogLevel convertit le niveau textuel de la Config en slog.Level,
+// en retombant sur Info pour une valeur inconnue.
+func parseLogLevel(level string) slog.Level {
+	switch strings.ToLower(level) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// newLogger construit le logger racine d'après cfg.LogLevel et cfg.LogFormat
+// ("json" pour une sortie machine-parsable, tout autre valeur pour du texte).
+func newLogger(cfg *Config) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: parseLogLevel(cfg.LogLevel)}
+
+	var handler slog.Handler
+	if strings.EqualFold(cfg.LogFormat, "json") {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	}
+
+	return slog.New(handler)
+}
+
+// loggerContextKey est le type de clé de contexte utilisé pour porter le
+// logger par requête, afin d'éviter toute collision avec d'autres paquets.
+type loggerContextKey struct{}
+
+// loggerFromContext renvoie le logger par requête porté par ctx (avec son
+// X-Request-ID), ou le logger racine si le contexte n'en porte pas.
+func loggerFromContext(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerContextKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return logger
+}
+
+// generateRequestID produit un identifiant de requête court et unique,
+// utilisé pour le suivi X-Request-ID à travers le logging structuré.
+func generateRequestID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return fmt.Sprintf("%d", time.Now().UnixNano())
+	}
+	return hex.EncodeToString(buf)
+}
+
 // ============================================================================
 // STRUCTURES DE DONNÉES POUR L'API
 // ============================================================================
@@ -81,6 +238,14 @@ type ArtistComplete struct {
 	LocationsList  []string            `json:"locationsList"`
 	DatesList      []string            `json:"datesList"`
 	DatesLocations map[string][]string `json:"datesLocations"`
+	GeoLocations   []GeoPoint          `json:"geoLocations"`
+}
+
+// GeoPoint représente les coordonnées géographiques d'un lieu de concert.
+type GeoPoint struct {
+	Name string  `json:"name"`
+	Lat  float64 `json:"lat"`
+	Lon  float64 `json:"lon"`
 }
 
 // APIResponse représente la réponse principale de l'API
@@ -95,76 +260,346 @@ type APIResponse struct {
 // CLIENT API AVEC CACHE
 // ============================================================================
 
-// APIClient gère les appels à l'API avec un système de cache
+const (
+	// DefaultLRUSize est le nombre d'entrées conservées en mémoire (L1).
+	DefaultLRUSize = 200
+	// DefaultDiskCacheDir est le répertoire du cache L2 persistant sur disque.
+	DefaultDiskCacheDir = "./cache/http"
+)
+
+// APIClient gère les appels à l'API avec un cache à deux niveaux: L1 en
+// mémoire (LRU) et L2 sur disque, qui survit aux redémarrages. Les entrées
+// expirées sont servies immédiatement (stale-while-revalidate) pendant
+// qu'une revalidation conditionnelle (ETag/Last-Modified) tourne en tâche
+// de fond.
 type APIClient struct {
-	httpClient *http.Client
-	cache      map[string]cacheEntry
-	cacheMutex sync.RWMutex
-	cacheTTL   time.Duration
+	httpClient   *http.Client
+	baseURL      string
+	l1           *lruCache
+	diskCacheDir string
+	cacheTTL     time.Duration
+	metrics      CacheMetrics
+	// revalidating marque les URLs dont la revalidation stale-while-revalidate
+	// est déjà en vol, pour que N requêtes concurrentes sur la même entrée
+	// périmée ne déclenchent qu'un seul GET amont au lieu de N.
+	revalidating sync.Map
 }
 
+// cacheEntry est une entrée de cache, partagée entre L1 et sa sérialisation
+// JSON sur disque.
 type cacheEntry struct {
-	data      []byte
-	timestamp time.Time
+	URL          string    `json:"url"`
+	Data         []byte    `json:"data"`
+	ETag         string    `json:"etag"`
+	LastModified string    `json:"lastModified"`
+	FetchedAt    time.Time `json:"fetchedAt"`
+}
+
+// isFresh indique si l'entrée est encore dans sa fenêtre de fraîcheur ttl.
+func (e cacheEntry) isFresh(ttl time.Duration) bool {
+	return time.Since(e.FetchedAt) < ttl
+}
+
+// CacheMetrics comptabilise l'activité du cache, exposée en JSON via /metrics.
+type CacheMetrics struct {
+	Hits          int64
+	Misses        int64
+	Revalidations int64
+	BytesSaved    int64
+}
+
+// requestCacheHitsContextKey porte, par requête, le nombre de hits de cache
+// amont observés pendant son traitement. Contrairement au compteur global
+// CacheMetrics.Hits (partagé par toutes les requêtes concurrentes), celui-ci
+// peut servir de champ fiable dans le log de fin de requête de
+// loggingMiddleware.
+type requestCacheHitsContextKey struct{}
+
+// recordCacheHit incrémente le compteur global de métriques ainsi que, si
+// ctx en porte un, le compteur propre à la requête en cours.
+func recordCacheHit(ctx context.Context, c *APIClient) {
+	atomic.AddInt64(&c.metrics.Hits, 1)
+	if counter, ok := ctx.Value(requestCacheHitsContextKey{}).(*int64); ok {
+		atomic.AddInt64(counter, 1)
+	}
+}
+
+// lruItem est l'élément stocké dans la liste chaînée du lruCache.
+type lruItem struct {
+	key   string
+	entry cacheEntry
+}
+
+// lruCache est un cache LRU en mémoire de taille fixe, protégé par mutex.
+type lruCache struct {
+	mutex    sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+// newLRUCache crée un cache LRU pouvant contenir capacity entrées.
+func newLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+// get renvoie l'entrée associée à key et la remonte en tête de liste.
+func (c *lruCache) get(key string) (cacheEntry, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	elem, ok := c.items[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*lruItem).entry, true
+}
+
+// put enregistre ou met à jour l'entrée de key, et évince la plus ancienne
+// si la capacité est dépassée.
+func (c *lruCache) put(key string, entry cacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if elem, ok := c.items[key]; ok {
+		elem.Value.(*lruItem).entry = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	elem := c.order.PushFront(&lruItem{key: key, entry: entry})
+	c.items[key] = elem
+
+	if c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.items, oldest.Value.(*lruItem).key)
+		}
+	}
+}
+
+// clear vide entièrement le cache LRU.
+func (c *lruCache) clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.order = list.New()
+	c.items = make(map[string]*list.Element)
 }
 
-// NewAPIClient crée un nouveau client API
-func NewAPIClient() *APIClient {
-	return &APIClient{
+// NewAPIClient crée un nouveau client API avec son cache à deux niveaux, et
+// précharge le L1 depuis le cache disque existant. lruSize dimensionne le L1
+// (voir Config.CacheLRUSize); une valeur <= 0 retombe sur DefaultLRUSize.
+func NewAPIClient(baseURL string, cacheTTL, httpTimeout time.Duration, lruSize int) *APIClient {
+	if lruSize <= 0 {
+		lruSize = DefaultLRUSize
+	}
+	client := &APIClient{
 		httpClient: &http.Client{
-			Timeout: 10 * time.Second,
+			Timeout: httpTimeout,
 		},
-		cache:    make(map[string]cacheEntry),
-		cacheTTL: 5 * time.Minute, // Cache de 5 minutes
+		baseURL:      baseURL,
+		l1:           newLRUCache(lruSize),
+		diskCacheDir: DefaultDiskCacheDir,
+		cacheTTL:     cacheTTL,
+	}
+	client.warmupFromDisk()
+	return client
+}
+
+// diskPathFor renvoie le chemin du fichier de cache L2 pour url, nommé
+// d'après son empreinte SHA-256 pour éviter tout souci d'échappement.
+func (c *APIClient) diskPathFor(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.diskCacheDir, hex.EncodeToString(sum[:])+".json")
+}
+
+// loadFromDisk relit une entrée depuis le cache L2, si elle existe.
+func (c *APIClient) loadFromDisk(url string) (cacheEntry, bool) {
+	raw, err := os.ReadFile(c.diskPathFor(url))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var entry cacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		logger.Warn("entrée de cache disque illisible", "url", url, "error", err)
+		return cacheEntry{}, false
+	}
+	return entry, true
+}
+
+// saveToDisk persiste une entrée dans le cache L2.
+func (c *APIClient) saveToDisk(url string, entry cacheEntry) {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		logger.Warn("sérialisation du cache disque impossible", "url", url, "error", err)
+		return
+	}
+
+	if err := os.MkdirAll(c.diskCacheDir, 0o755); err != nil {
+		logger.Warn("création du dossier de cache disque impossible", "error", err)
+		return
+	}
+	if err := os.WriteFile(c.diskPathFor(url), raw, 0o644); err != nil {
+		logger.Warn("écriture du cache disque impossible", "url", url, "error", err)
+	}
+}
+
+// warmupFromDisk précharge le L1 avec toutes les entrées déjà présentes sur
+// disque, pour que le premier appel après un redémarrage ne soit pas un miss.
+func (c *APIClient) warmupFromDisk() {
+	files, err := os.ReadDir(c.diskCacheDir)
+	if err != nil {
+		return
+	}
+
+	loaded := 0
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		raw, err := os.ReadFile(filepath.Join(c.diskCacheDir, file.Name()))
+		if err != nil {
+			continue
+		}
+		var entry cacheEntry
+		if err := json.Unmarshal(raw, &entry); err != nil || entry.URL == "" {
+			continue
+		}
+		c.l1.put(entry.URL, entry)
+		loaded++
+	}
+
+	if loaded > 0 {
+		logger.Info("préchargement du cache disque", "entries", loaded)
 	}
 }
 
-// fetchURL récupère les données d'une URL avec gestion du cache
-func (c *APIClient) fetchURL(url string) ([]byte, error) {
-	// Vérifier le cache
-	c.cacheMutex.RLock()
-	if entry, exists := c.cache[url]; exists {
-		if time.Since(entry.timestamp) < c.cacheTTL {
-			c.cacheMutex.RUnlock()
-			log.Printf("Cache hit pour: %s", url)
-			return entry.data, nil
+// fetchURL récupère les données d'une URL avec gestion du cache L1/L2. Une
+// entrée périmée est renvoyée immédiatement (stale-while-revalidate) pendant
+// qu'une revalidation conditionnelle tourne en arrière-plan, détachée de ctx
+// pour ne pas être annulée par la déconnexion du client à l'origine de l'appel.
+func (c *APIClient) fetchURL(ctx context.Context, url string) ([]byte, error) {
+	if entry, exists := c.l1.get(url); exists {
+		if entry.isFresh(c.cacheTTL) {
+			recordCacheHit(ctx, c)
+			logger.Debug("cache hit (mémoire)", "url", url)
+			return entry.Data, nil
 		}
+		recordCacheHit(ctx, c)
+		logger.Debug("cache périmé, revalidation en arrière-plan", "url", url)
+		c.scheduleRevalidate(url, entry)
+		return entry.Data, nil
+	}
+
+	if entry, exists := c.loadFromDisk(url); exists {
+		c.l1.put(url, entry)
+		if entry.isFresh(c.cacheTTL) {
+			recordCacheHit(ctx, c)
+			logger.Debug("cache hit (disque)", "url", url)
+			return entry.Data, nil
+		}
+		recordCacheHit(ctx, c)
+		logger.Debug("cache périmé, revalidation en arrière-plan", "url", url)
+		c.scheduleRevalidate(url, entry)
+		return entry.Data, nil
+	}
+
+	atomic.AddInt64(&c.metrics.Misses, 1)
+	entry, err := c.fetchAndStore(ctx, url, cacheEntry{})
+	if err != nil {
+		return nil, err
+	}
+	return entry.Data, nil
+}
+
+// scheduleRevalidate lance une revalidation en arrière-plan pour url, sauf si
+// une revalidation est déjà en vol pour cette même URL: les requêtes
+// concurrentes qui observent toutes la même entrée périmée se coalescent
+// ainsi en un seul GET amont plutôt que d'en déclencher une par requête.
+func (c *APIClient) scheduleRevalidate(url string, previous cacheEntry) {
+	if _, alreadyRunning := c.revalidating.LoadOrStore(url, struct{}{}); alreadyRunning {
+		return
+	}
+	go c.revalidate(url, previous)
+}
+
+// revalidate rafraîchit une entrée périmée en arrière-plan, sans bloquer la
+// requête qui a servi les données périmées. Elle tourne sur un contexte
+// indépendant de la requête HTTP d'origine, qui peut déjà être terminée.
+func (c *APIClient) revalidate(url string, previous cacheEntry) {
+	defer c.revalidating.Delete(url)
+	if _, err := c.fetchAndStore(context.Background(), url, previous); err != nil {
+		logger.Warn("revalidation échouée", "url", url, "error", err)
+	}
+}
+
+// fetchAndStore effectue la requête HTTP amont (conditionnelle si previous
+// porte un ETag/Last-Modified), met à jour L1/L2 et les métriques. La requête
+// est annulée si ctx l'est (déconnexion du client).
+func (c *APIClient) fetchAndStore(ctx context.Context, url string, previous cacheEntry) (cacheEntry, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return cacheEntry{}, fmt.Errorf("erreur lors de la construction de la requête: %w", err)
+	}
+	if previous.ETag != "" {
+		req.Header.Set("If-None-Match", previous.ETag)
+	}
+	if previous.LastModified != "" {
+		req.Header.Set("If-Modified-Since", previous.LastModified)
 	}
-	c.cacheMutex.RUnlock()
 
-	// Faire la requête HTTP
-	log.Printf("Requête API: %s", url)
-	resp, err := c.httpClient.Get(url)
+	logger.Debug("requête API amont", "url", url)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("erreur lors de la requête HTTP: %w", err)
+		return cacheEntry{}, fmt.Errorf("erreur lors de la requête HTTP: %w", err)
 	}
 	defer resp.Body.Close()
 
-	// Vérifier le code de statut
+	if resp.StatusCode == http.StatusNotModified {
+		atomic.AddInt64(&c.metrics.Revalidations, 1)
+		atomic.AddInt64(&c.metrics.BytesSaved, int64(len(previous.Data)))
+
+		refreshed := previous
+		refreshed.URL = url
+		refreshed.FetchedAt = time.Now()
+		c.l1.put(url, refreshed)
+		c.saveToDisk(url, refreshed)
+		logger.Debug("304 Not Modified, cache rafraîchi", "url", url)
+		return refreshed, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("statut HTTP inattendu: %d %s", resp.StatusCode, resp.Status)
+		return cacheEntry{}, fmt.Errorf("statut HTTP inattendu: %d %s", resp.StatusCode, resp.Status)
 	}
 
-	// Lire le corps de la réponse
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("erreur lors de la lecture de la réponse: %w", err)
+		return cacheEntry{}, fmt.Errorf("erreur lors de la lecture de la réponse: %w", err)
 	}
 
-	// Mettre en cache
-	c.cacheMutex.Lock()
-	c.cache[url] = cacheEntry{
-		data:      body,
-		timestamp: time.Now(),
+	entry := cacheEntry{
+		URL:          url,
+		Data:         body,
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+		FetchedAt:    time.Now(),
 	}
-	c.cacheMutex.Unlock()
+	c.l1.put(url, entry)
+	c.saveToDisk(url, entry)
 
-	return body, nil
+	return entry, nil
 }
 
 // GetArtists récupère la liste de tous les artistes
-func (c *APIClient) GetArtists() ([]Artist, error) {
-	data, err := c.fetchURL(ArtistsEndpoint)
+func (c *APIClient) GetArtists(ctx context.Context) ([]Artist, error) {
+	data, err := c.fetchURL(ctx, c.baseURL+artistsPath)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération des artistes: %w", err)
 	}
@@ -174,14 +609,14 @@ func (c *APIClient) GetArtists() ([]Artist, error) {
 		return nil, fmt.Errorf("erreur lors du parsing JSON des artistes: %w", err)
 	}
 
-	log.Printf("Récupération réussie de %d artistes", len(artists))
+	logger.Info("récupération des artistes réussie", "count", len(artists))
 	return artists, nil
 }
 
 // GetArtistByID récupère un artiste spécifique par son ID
-func (c *APIClient) GetArtistByID(id int) (*Artist, error) {
-	url := fmt.Sprintf("%s/%d", ArtistsEndpoint, id)
-	data, err := c.fetchURL(url)
+func (c *APIClient) GetArtistByID(ctx context.Context, id int) (*Artist, error) {
+	url := fmt.Sprintf("%s%s/%d", c.baseURL, artistsPath, id)
+	data, err := c.fetchURL(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération de l'artiste %d: %w", id, err)
 	}
@@ -195,8 +630,8 @@ func (c *APIClient) GetArtistByID(id int) (*Artist, error) {
 }
 
 // GetLocations récupère toutes les locations
-func (c *APIClient) GetLocations() (*LocationsIndex, error) {
-	data, err := c.fetchURL(LocationsEndpoint)
+func (c *APIClient) GetLocations(ctx context.Context) (*LocationsIndex, error) {
+	data, err := c.fetchURL(ctx, c.baseURL+locationsPath)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération des locations: %w", err)
 	}
@@ -206,14 +641,14 @@ func (c *APIClient) GetLocations() (*LocationsIndex, error) {
 		return nil, fmt.Errorf("erreur lors du parsing JSON des locations: %w", err)
 	}
 
-	log.Printf("Récupération réussie de %d locations", len(locations.Index))
+	logger.Info("récupération des locations réussie", "count", len(locations.Index))
 	return &locations, nil
 }
 
 // GetLocationsByArtistID récupère les locations d'un artiste spécifique
-func (c *APIClient) GetLocationsByArtistID(id int) (*LocationsData, error) {
-	url := fmt.Sprintf("%s/%d", LocationsEndpoint, id)
-	data, err := c.fetchURL(url)
+func (c *APIClient) GetLocationsByArtistID(ctx context.Context, id int) (*LocationsData, error) {
+	url := fmt.Sprintf("%s%s/%d", c.baseURL, locationsPath, id)
+	data, err := c.fetchURL(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération des locations de l'artiste %d: %w", id, err)
 	}
@@ -227,8 +662,8 @@ func (c *APIClient) GetLocationsByArtistID(id int) (*LocationsData, error) {
 }
 
 // GetDates récupère toutes les dates de concert
-func (c *APIClient) GetDates() (*DatesIndex, error) {
-	data, err := c.fetchURL(DatesEndpoint)
+func (c *APIClient) GetDates(ctx context.Context) (*DatesIndex, error) {
+	data, err := c.fetchURL(ctx, c.baseURL+datesPath)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération des dates: %w", err)
 	}
@@ -238,14 +673,14 @@ func (c *APIClient) GetDates() (*DatesIndex, error) {
 		return nil, fmt.Errorf("erreur lors du parsing JSON des dates: %w", err)
 	}
 
-	log.Printf("Récupération réussie de %d dates", len(dates.Index))
+	logger.Info("récupération des dates réussie", "count", len(dates.Index))
 	return &dates, nil
 }
 
 // GetDatesByArtistID récupère les dates de concert d'un artiste spécifique
-func (c *APIClient) GetDatesByArtistID(id int) (*DatesData, error) {
-	url := fmt.Sprintf("%s/%d", DatesEndpoint, id)
-	data, err := c.fetchURL(url)
+func (c *APIClient) GetDatesByArtistID(ctx context.Context, id int) (*DatesData, error) {
+	url := fmt.Sprintf("%s%s/%d", c.baseURL, datesPath, id)
+	data, err := c.fetchURL(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération des dates de l'artiste %d: %w", id, err)
 	}
@@ -259,8 +694,8 @@ func (c *APIClient) GetDatesByArtistID(id int) (*DatesData, error) {
 }
 
 // GetRelations récupère toutes les relations artiste-concerts
-func (c *APIClient) GetRelations() (*RelationIndex, error) {
-	data, err := c.fetchURL(RelationEndpoint)
+func (c *APIClient) GetRelations(ctx context.Context) (*RelationIndex, error) {
+	data, err := c.fetchURL(ctx, c.baseURL+relationPath)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération des relations: %w", err)
 	}
@@ -270,14 +705,14 @@ func (c *APIClient) GetRelations() (*RelationIndex, error) {
 		return nil, fmt.Errorf("erreur lors du parsing JSON des relations: %w", err)
 	}
 
-	log.Printf("Récupération réussie de %d relations", len(relations.Index))
+	logger.Info("récupération des relations réussie", "count", len(relations.Index))
 	return &relations, nil
 }
 
 // GetRelationByArtistID récupère la relation d'un artiste spécifique
-func (c *APIClient) GetRelationByArtistID(id int) (*Relation, error) {
-	url := fmt.Sprintf("%s/%d", RelationEndpoint, id)
-	data, err := c.fetchURL(url)
+func (c *APIClient) GetRelationByArtistID(ctx context.Context, id int) (*Relation, error) {
+	url := fmt.Sprintf("%s%s/%d", c.baseURL, relationPath, id)
+	data, err := c.fetchURL(ctx, url)
 	if err != nil {
 		return nil, fmt.Errorf("erreur lors de la récupération de la relation de l'artiste %d: %w", id, err)
 	}
@@ -290,10 +725,289 @@ func (c *APIClient) GetRelationByArtistID(id int) (*Relation, error) {
 	return &relation, nil
 }
 
-// GetArtistComplete récupère un artiste avec toutes ses données (locations, dates, relations)
-func (c *APIClient) GetArtistComplete(id int) (*ArtistComplete, error) {
+// ============================================================================
+// GÉOCODAGE DES LIEUX DE CONCERT
+// ============================================================================
+
+// Geocoder résout un nom de lieu en coordonnées géographiques. L'implémentation
+// par défaut interroge Nominatim/OpenStreetMap, mais toute autre source
+// (service payant, base locale) peut être branchée derrière cette interface.
+type Geocoder interface {
+	Geocode(location string) (GeoPoint, error)
+}
+
+// NominatimGeocoder interroge l'API de recherche de Nominatim (OpenStreetMap).
+// Nominatim impose une limite d'1 requête par seconde: lastRequest/mutex la
+// font respecter côté client.
+type NominatimGeocoder struct {
+	httpClient  *http.Client
+	baseURL     string
+	mutex       sync.Mutex
+	lastRequest time.Time
+}
+
+// NewNominatimGeocoder crée un géocodeur Nominatim prêt à l'emploi.
+func NewNominatimGeocoder() *NominatimGeocoder {
+	return &NominatimGeocoder{
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+		baseURL:    "https://nominatim.openstreetmap.org/search",
+	}
+}
+
+// nominatimResult représente une entrée de la réponse JSON de Nominatim.
+type nominatimResult struct {
+	Lat string `json:"lat"`
+	Lon string `json:"lon"`
+}
+
+// Geocode résout location (déjà normalisé) en coordonnées, en respectant la
+// limite d'1 requête/seconde imposée par Nominatim.
+func (g *NominatimGeocoder) Geocode(location string) (GeoPoint, error) {
+	g.mutex.Lock()
+	if elapsed := time.Since(g.lastRequest); elapsed < time.Second {
+		time.Sleep(time.Second - elapsed)
+	}
+	g.lastRequest = time.Now()
+	g.mutex.Unlock()
+
+	requestURL := fmt.Sprintf("%s?q=%s&format=json&limit=1", g.baseURL, url.QueryEscape(location))
+
+	req, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return GeoPoint{}, fmt.Errorf("erreur lors de la construction de la requête de géocodage: %w", err)
+	}
+	// Nominatim exige un User-Agent identifiable pour chaque client.
+	req.Header.Set("User-Agent", "Groupie-Tracker/1.0")
+
+	resp, err := g.httpClient.Do(req)
+	if err != nil {
+		return GeoPoint{}, fmt.Errorf("erreur lors de la requête de géocodage: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return GeoPoint{}, fmt.Errorf("statut HTTP inattendu du géocodeur: %d %s", resp.StatusCode, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return GeoPoint{}, fmt.Errorf("erreur lors de la lecture de la réponse de géocodage: %w", err)
+	}
+
+	var results []nominatimResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		return GeoPoint{}, fmt.Errorf("erreur lors du parsing JSON de géocodage: %w", err)
+	}
+	if len(results) == 0 {
+		return GeoPoint{}, fmt.Errorf("aucun résultat de géocodage pour '%s'", location)
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return GeoPoint{}, fmt.Errorf("latitude invalide reçue du géocodeur: %w", err)
+	}
+	lon, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return GeoPoint{}, fmt.Errorf("longitude invalide reçue du géocodeur: %w", err)
+	}
+
+	return GeoPoint{Name: location, Lat: lat, Lon: lon}, nil
+}
+
+// GeoCache persiste les résultats de géocodage sur disque (./cache/geocache.json)
+// pour survivre aux redémarrages et ménager le quota de l'API amont.
+type GeoCache struct {
+	mutex sync.RWMutex
+	path  string
+	data  map[string]GeoPoint
+}
+
+// NewGeoCache charge le cache de géocodage existant depuis path, s'il existe.
+func NewGeoCache(path string) *GeoCache {
+	c := &GeoCache{path: path, data: make(map[string]GeoPoint)}
+	c.load()
+	return c
+}
+
+// load relit le fichier de cache depuis le disque; un fichier absent ou
+// invalide n'est pas fatal, le cache démarre simplement vide.
+func (c *GeoCache) load() {
+	raw, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+
+	var entries map[string]GeoPoint
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		logger.Warn("cache de géocodage illisible", "path", c.path, "error", err)
+		return
+	}
+
+	c.mutex.Lock()
+	c.data = entries
+	c.mutex.Unlock()
+}
+
+// save réécrit le cache de géocodage sur disque.
+func (c *GeoCache) save() {
+	c.mutex.RLock()
+	raw, err := json.MarshalIndent(c.data, "", "  ")
+	c.mutex.RUnlock()
+	if err != nil {
+		logger.Warn("sérialisation du cache de géocodage impossible", "error", err)
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		logger.Warn("création du dossier de cache de géocodage impossible", "error", err)
+		return
+	}
+	if err := os.WriteFile(c.path, raw, 0o644); err != nil {
+		logger.Warn("écriture du cache de géocodage impossible", "error", err)
+	}
+}
+
+// Get renvoie un point géocodé déjà connu pour key, s'il existe.
+func (c *GeoCache) Get(key string) (GeoPoint, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	point, ok := c.data[key]
+	return point, ok
+}
+
+// Set enregistre un point géocodé pour key et persiste le cache sur disque.
+func (c *GeoCache) Set(key string, point GeoPoint) {
+	c.mutex.Lock()
+	c.data[key] = point
+	c.mutex.Unlock()
+	c.save()
+}
+
+// locationNameReplacer normalise les clés de lieu dénormalisées de l'API
+// amont (ex: "north_carolina-usa") en "north carolina usa".
+var locationNameReplacer = strings.NewReplacer("_", " ", "-", " ")
+
+// normalizeLocationName sépare les composants d'une clé de lieu par des
+// espaces simples, prêts à être envoyés au géocodeur.
+func normalizeLocationName(location string) string {
+	return strings.Join(strings.Fields(locationNameReplacer.Replace(location)), " ")
+}
+
+// collectLocationKeys rassemble, sans doublon, les lieux de concert connus
+// d'un artiste à partir de LocationsList et des clés de DatesLocations.
+func collectLocationKeys(complete *ArtistComplete) []string {
+	seen := make(map[string]bool)
+	keys := make([]string, 0, len(complete.LocationsList))
+
+	addKey := func(key string) {
+		if key == "" || seen[key] {
+			return
+		}
+		seen[key] = true
+		keys = append(keys, key)
+	}
+
+	for _, location := range complete.LocationsList {
+		addKey(location)
+	}
+	for location := range complete.DatesLocations {
+		addKey(location)
+	}
+
+	return keys
+}
+
+// geoJobQueue géocode les lieux de concert en arrière-plan, en file et avec
+// déduplication, pour que resolveGeoLocations ne bloque jamais une requête
+// HTTP sur l'appel réseau vers Nominatim (limité à 1 req/s par NominatimGeocoder).
+// Ceci réalise le "worker" de résolution demandé, découplé du service des requêtes.
+type geoJobQueue struct {
+	mutex  sync.Mutex
+	queued map[string]bool
+	jobs   chan string
+}
+
+// newGeoJobQueue crée une file de géocodage et démarre son unique worker.
+// Un seul worker suffit: NominatimGeocoder sérialise de toute façon les
+// appels à 1 req/s en interne.
+func newGeoJobQueue() *geoJobQueue {
+	q := &geoJobQueue{
+		queued: make(map[string]bool),
+		jobs:   make(chan string, 256),
+	}
+	go q.run()
+	return q
+}
+
+// enqueue programme le géocodage de location s'il n'est pas déjà en file.
+// Best-effort et non bloquant: si la file est pleine, l'appelant réessaiera
+// au prochain passage (location reste absente du GeoCache jusque-là).
+func (q *geoJobQueue) enqueue(location string) {
+	q.mutex.Lock()
+	if q.queued[location] {
+		q.mutex.Unlock()
+		return
+	}
+	q.queued[location] = true
+	q.mutex.Unlock()
+
+	select {
+	case q.jobs <- location:
+	default:
+		q.mutex.Lock()
+		delete(q.queued, location)
+		q.mutex.Unlock()
+	}
+}
+
+// run consomme la file et peuple geoCache au fil des résolutions, pour que
+// les requêtes suivantes trouvent le lieu déjà en cache.
+func (q *geoJobQueue) run() {
+	for location := range q.jobs {
+		point, err := geocoder.Geocode(location)
+
+		q.mutex.Lock()
+		delete(q.queued, location)
+		q.mutex.Unlock()
+
+		if err != nil {
+			logger.Warn("géocodage en arrière-plan impossible", "location", location, "error", err)
+			continue
+		}
+		geoCache.Set(location, point)
+	}
+}
+
+// resolveGeoLocations renvoie les lieux de concert déjà géocodés pour un
+// artiste depuis le GeoCache. Les lieux inconnus sont programmés pour un
+// géocodage asynchrone via geoJobs et apparaîtront aux requêtes suivantes,
+// une fois que le worker les aura résolus.
+func resolveGeoLocations(locations []string) []GeoPoint {
+	points := make([]GeoPoint, 0, len(locations))
+
+	for _, raw := range locations {
+		normalized := normalizeLocationName(raw)
+		if normalized == "" {
+			continue
+		}
+
+		if point, ok := geoCache.Get(normalized); ok {
+			points = append(points, point)
+			continue
+		}
+
+		geoJobs.enqueue(normalized)
+	}
+
+	return points
+}
+
+// GetArtistComplete récupère un artiste avec toutes ses données (locations, dates, relations).
+// Les trois sous-requêtes sont indépendantes: elles sont lancées en parallèle via un
+// errgroup lié à ctx, qui les annule toutes si le client se déconnecte.
+func (c *APIClient) GetArtistComplete(ctx context.Context, id int) (*ArtistComplete, error) {
 	// Récupérer l'artiste de base
-	artist, err := c.GetArtistByID(id)
+	artist, err := c.GetArtistByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -303,41 +1017,71 @@ func (c *APIClient) GetArtistComplete(id int) (*ArtistComplete, error) {
 		Artist: *artist,
 	}
 
-	// Récupérer les locations
-	locations, err := c.GetLocationsByArtistID(id)
-	if err != nil {
-		log.Printf("Avertissement: impossible de récupérer les locations pour l'artiste %d: %v", id, err)
-	} else {
-		complete.LocationsList = locations.Locations
+	g, gctx := errgroup.WithContext(ctx)
+
+	var locations *LocationsData
+	g.Go(func() error {
+		l, err := c.GetLocationsByArtistID(gctx, id)
+		if err != nil {
+			logger.Warn("récupération des locations impossible", "artistId", id, "error", err)
+			return nil
+		}
+		locations = l
+		return nil
+	})
+
+	var dates *DatesData
+	g.Go(func() error {
+		d, err := c.GetDatesByArtistID(gctx, id)
+		if err != nil {
+			logger.Warn("récupération des dates impossible", "artistId", id, "error", err)
+			return nil
+		}
+		dates = d
+		return nil
+	})
+
+	var relation *Relation
+	g.Go(func() error {
+		r, err := c.GetRelationByArtistID(gctx, id)
+		if err != nil {
+			logger.Warn("récupération des relations impossible", "artistId", id, "error", err)
+			return nil
+		}
+		relation = r
+		return nil
+	})
+
+	// Les erreurs individuelles sont déjà traitées comme des avertissements
+	// ci-dessus; g.Wait() ne peut renvoyer que l'annulation de ctx.
+	if err := g.Wait(); err != nil {
+		return nil, fmt.Errorf("requête annulée pendant la récupération de l'artiste %d: %w", id, err)
 	}
 
-	// Récupérer les dates
-	dates, err := c.GetDatesByArtistID(id)
-	if err != nil {
-		log.Printf("Avertissement: impossible de récupérer les dates pour l'artiste %d: %v", id, err)
-	} else {
+	if locations != nil {
+		complete.LocationsList = locations.Locations
+	}
+	if dates != nil {
 		complete.DatesList = dates.Dates
 	}
-
-	// Récupérer les relations (map lieu -> dates)
-	relation, err := c.GetRelationByArtistID(id)
-	if err != nil {
-		log.Printf("Avertissement: impossible de récupérer les relations pour l'artiste %d: %v", id, err)
-	} else {
+	if relation != nil {
 		complete.DatesLocations = relation.DatesLocations
 	}
 
+	// Géocoder les lieux de concert pour la vue carte
+	complete.GeoLocations = resolveGeoLocations(collectLocationKeys(complete))
+
 	return complete, nil
 }
 
 // GetAllArtistsComplete récupère tous les artistes avec leurs données complètes
-func (c *APIClient) GetAllArtistsComplete() ([]ArtistComplete, error) {
-	artists, err := c.GetArtists()
+func (c *APIClient) GetAllArtistsComplete(ctx context.Context) ([]ArtistComplete, error) {
+	artists, err := c.GetArtists(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	relations, err := c.GetRelations()
+	relations, err := c.GetRelations(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -364,10 +1108,16 @@ func (c *APIClient) GetAllArtistsComplete() ([]ArtistComplete, error) {
 
 // ClearCache vide le cache de l'API
 func (c *APIClient) ClearCache() {
-	c.cacheMutex.Lock()
-	c.cache = make(map[string]cacheEntry)
-	c.cacheMutex.Unlock()
-	log.Println("Cache API vidé")
+	c.l1.clear()
+
+	files, err := os.ReadDir(c.diskCacheDir)
+	if err == nil {
+		for _, file := range files {
+			os.Remove(filepath.Join(c.diskCacheDir, file.Name()))
+		}
+	}
+
+	logger.Info("cache API vidé")
 }
 
 // ============================================================================
@@ -376,6 +1126,14 @@ func (c *APIClient) ClearCache() {
 
 var apiClient *APIClient
 
+// Géocodeur, cache disque et file de résolution asynchrone utilisés pour
+// résoudre les lieux de concert
+var (
+	geocoder Geocoder
+	geoCache *GeoCache
+	geoJobs  *geoJobQueue
+)
+
 // Structure pour les données d'erreur envoyées aux templates
 type ErrorData struct {
 	Code    int
@@ -386,32 +1144,74 @@ type ErrorData struct {
 // Variables globales pour les templates
 var templates *template.Template
 
-// Initialisation des templates et du client API au démarrage
-func init() {
+// setup initialise les templates, le client API et le géocodeur à partir de
+// la configuration chargée par loadConfig. Remplace l'ancien init(), qui
+// s'exécutait avant que les flags/variables d'environnement ne soient lus.
+func setup(cfg *Config) {
 	var err error
-	templates, err = template.ParseGlob("./templates/*.html")
+	templates, err = template.ParseGlob(filepath.Join(cfg.TemplatesDir, "*.html"))
 	if err != nil {
-		log.Fatalf("Erreur lors du chargement des templates: %v", err)
+		logger.Error("chargement des templates impossible", "error", err)
+		os.Exit(1)
 	}
 
-	// Initialiser le client API
-	apiClient = NewAPIClient()
-	log.Println("Client API initialisé")
+	apiClient = NewAPIClient(cfg.APIBaseURL, cfg.CacheTTL, cfg.HTTPTimeout, cfg.CacheLRUSize)
+	logger.Info("client API initialisé", "baseURL", cfg.APIBaseURL, "cacheTTL", cfg.CacheTTL)
+
+	geocoder = NewNominatimGeocoder()
+	geoCache = NewGeoCache("./cache/geocache.json")
+	geoJobs = newGeoJobQueue()
+	logger.Info("géocodeur initialisé")
+}
+
+// statusRecorder intercepte le code de statut écrit par un handler afin que
+// loggingMiddleware puisse le consigner une fois la requête terminée.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (rec *statusRecorder) WriteHeader(code int) {
+	rec.status = code
+	rec.ResponseWriter.WriteHeader(code)
 }
 
-// Middleware de logging pour tracer les requêtes HTTP
+// Middleware de logging pour tracer les requêtes HTTP. Génère (ou propage) un
+// identifiant de requête, construit un logger enrichi propre à la requête et
+// le porte via le contexte pour que handlers et APIClient y aient accès.
 func loggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		start := time.Now()
-		log.Printf("[%s] %s %s - Début de la requête", r.Method, r.URL.Path, r.RemoteAddr)
-		next.ServeHTTP(w, r)
-		log.Printf("[%s] %s %s - Terminé en %v", r.Method, r.URL.Path, r.RemoteAddr, time.Since(start))
+
+		requestID := r.Header.Get("X-Request-ID")
+		if requestID == "" {
+			requestID = generateRequestID()
+		}
+		w.Header().Set("X-Request-ID", requestID)
+
+		reqLogger := logger.With("requestID", requestID, "method", r.Method, "path", r.URL.Path)
+		hitCounter := new(int64)
+		ctx := context.WithValue(r.Context(), loggerContextKey{}, reqLogger)
+		ctx = context.WithValue(ctx, requestCacheHitsContextKey{}, hitCounter)
+		r = r.WithContext(ctx)
+
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		reqLogger.Debug("début de la requête")
+		next.ServeHTTP(rec, r)
+
+		upstreamCacheHit := atomic.LoadInt64(hitCounter) > 0
+		reqLogger.Info("requête terminée",
+			"status", rec.status,
+			"durationMs", time.Since(start).Milliseconds(),
+			"upstreamCacheHit", upstreamCacheHit,
+		)
 	})
 }
 
 // Fonction centralisée pour gérer les erreurs HTTP
-func handleError(w http.ResponseWriter, code int, message string, details string) {
-	log.Printf("Erreur %d: %s - %s", code, message, details)
+func handleError(w http.ResponseWriter, r *http.Request, code int, message string, details string) {
+	loggerFromContext(r.Context()).Warn("erreur HTTP", "code", code, "message", message, "details", details)
 
 	w.WriteHeader(code)
 
@@ -425,33 +1225,33 @@ func handleError(w http.ResponseWriter, code int, message string, details string
 	err := templates.ExecuteTemplate(w, "error.html", data)
 	if err != nil {
 		// Si le template d'erreur n'existe pas, renvoyer une réponse texte simple
-		log.Printf("Erreur lors du rendu du template d'erreur: %v", err)
+		loggerFromContext(r.Context()).Warn("rendu du template d'erreur impossible", "error", err)
 		http.Error(w, fmt.Sprintf("Erreur %d: %s", code, message), code)
 	}
 }
 
 // Gestionnaire pour les erreurs 404 (Page non trouvée)
 func notFoundHandler(w http.ResponseWriter, r *http.Request) {
-	handleError(w, http.StatusNotFound, "Page non trouvée",
+	handleError(w, r, http.StatusNotFound, "Page non trouvée",
 		fmt.Sprintf("La page '%s' n'existe pas sur ce serveur.", r.URL.Path))
 }
 
 // Gestionnaire pour les erreurs 400 (Mauvaise requête)
-func badRequestHandler(w http.ResponseWriter, _ *http.Request, details string) {
-	handleError(w, http.StatusBadRequest, "Requête invalide", details)
+func badRequestHandler(w http.ResponseWriter, r *http.Request, details string) {
+	handleError(w, r, http.StatusBadRequest, "Requête invalide", details)
 }
 
 // Gestionnaire pour les erreurs 405 (Méthode non autorisée)
 func methodNotAllowedHandler(w http.ResponseWriter, r *http.Request) {
-	handleError(w, http.StatusMethodNotAllowed, "Méthode non autorisée",
+	handleError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée",
 		fmt.Sprintf("La méthode '%s' n'est pas autorisée pour cette ressource.", r.Method))
 }
 
 // Gestionnaire pour les erreurs 500 (Erreur interne du serveur)
-func internalServerErrorHandler(w http.ResponseWriter, err error) {
-	handleError(w, http.StatusInternalServerError, "Erreur interne du serveur",
+func internalServerErrorHandler(w http.ResponseWriter, r *http.Request, err error) {
+	loggerFromContext(r.Context()).Error("erreur interne", "error", err)
+	handleError(w, r, http.StatusInternalServerError, "Erreur interne du serveur",
 		"Une erreur inattendue s'est produite. Veuillez réessayer plus tard.")
-	log.Printf("Erreur interne: %v", err)
 }
 
 // PageData représente les données envoyées aux templates de page
@@ -476,10 +1276,10 @@ func homeHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Récupérer tous les artistes depuis l'API
-	artists, err := apiClient.GetAllArtistsComplete()
+	artists, err := apiClient.GetAllArtistsComplete(r.Context())
 	if err != nil {
-		log.Printf("Erreur lors de la récupération des artistes: %v", err)
-		internalServerErrorHandler(w, err)
+		loggerFromContext(r.Context()).Error("récupération des artistes impossible", "error", err)
+		internalServerErrorHandler(w, r, err)
 		return
 	}
 
@@ -491,7 +1291,7 @@ func homeHandler(w http.ResponseWriter, r *http.Request) {
 	// Exécuter le template
 	err = templates.ExecuteTemplate(w, "index.html", data)
 	if err != nil {
-		internalServerErrorHandler(w, err)
+		internalServerErrorHandler(w, r, err)
 		return
 	}
 }
@@ -520,9 +1320,9 @@ func artistHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Récupérer les données complètes de l'artiste
-	artist, err := apiClient.GetArtistComplete(id)
+	artist, err := apiClient.GetArtistComplete(r.Context(), id)
 	if err != nil {
-		log.Printf("Erreur lors de la récupération de l'artiste %d: %v", id, err)
+		loggerFromContext(r.Context()).Warn("artiste introuvable", "artistId", id, "error", err)
 		notFoundHandler(w, r)
 		return
 	}
@@ -535,9 +1335,592 @@ func artistHandler(w http.ResponseWriter, r *http.Request) {
 	// Exécuter le template
 	err = templates.ExecuteTemplate(w, "artist.html", data)
 	if err != nil {
-		internalServerErrorHandler(w, err)
+		internalServerErrorHandler(w, r, err)
+		return
+	}
+}
+
+// ============================================================================
+// API REST JSON (v1)
+// ============================================================================
+
+// APIPrefix est le préfixe de base de l'API REST versionnée.
+const APIPrefix = "/api/v1"
+
+// APIErrorBody représente le corps JSON renvoyé pour les erreurs de l'API,
+// au lieu du template HTML error.html utilisé par les pages rendues côté serveur.
+type APIErrorBody struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Details string `json:"details"`
+}
+
+// writeJSON sérialise v en JSON et l'écrit dans la réponse avec le bon
+// Content-Type. Le corps n'est pas écrit pour une requête HEAD, conformément
+// à la sémantique HTTP, mais les en-têtes (dont Content-Length) le sont.
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		writeJSONError(w, r, http.StatusInternalServerError, "Erreur interne du serveur", err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	if r.Method != http.MethodHead {
+		w.Write(body)
+	}
+}
+
+// writeJSONError envoie une erreur structurée {code, message, details} en JSON,
+// utilisé par tous les handlers de /api/v1 à la place de handleError.
+func writeJSONError(w http.ResponseWriter, r *http.Request, code int, message string, details string) {
+	loggerFromContext(r.Context()).Warn("erreur API", "code", code, "message", message, "details", details)
+	writeJSON(w, r, code, APIErrorBody{Code: code, Message: message, Details: details})
+}
+
+// apiMethodAllowed vérifie que la méthode de la requête est GET ou HEAD,
+// seules méthodes supportées par cette API en lecture seule, et répond en
+// 405 sinon (à l'image du dispatch par méthode des API artistes/relations amont).
+func apiMethodAllowed(w http.ResponseWriter, r *http.Request) bool {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		writeJSONError(w, r, http.StatusMethodNotAllowed, "Méthode non autorisée",
+			fmt.Sprintf("La méthode '%s' n'est pas autorisée pour cette ressource.", r.Method))
+		return false
+	}
+	return true
+}
+
+// apiArtistsListHandler gère GET/HEAD /api/v1/artists.
+func apiArtistsListHandler(w http.ResponseWriter, r *http.Request) {
+	if !apiMethodAllowed(w, r) {
+		return
+	}
+
+	artists, err := apiClient.GetAllArtistsComplete(r.Context())
+	if err != nil {
+		writeJSONError(w, r, http.StatusInternalServerError, "Erreur interne du serveur",
+			"Impossible de récupérer la liste des artistes.")
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, artists)
+}
+
+// apiArtistSubHandler gère GET/HEAD sur:
+//   - /api/v1/artists/{id}
+//   - /api/v1/artists/{id}/locations
+//   - /api/v1/artists/{id}/dates
+//   - /api/v1/artists/{id}/relations
+func apiArtistSubHandler(w http.ResponseWriter, r *http.Request) {
+	if !apiMethodAllowed(w, r) {
+		return
+	}
+
+	rest := strings.TrimPrefix(r.URL.Path, APIPrefix+"/artists/")
+	parts := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(parts) == 0 || parts[0] == "" {
+		writeJSONError(w, r, http.StatusNotFound, "Ressource non trouvée",
+			fmt.Sprintf("La ressource '%s' n'existe pas.", r.URL.Path))
+		return
+	}
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil || id < 1 {
+		writeJSONError(w, r, http.StatusBadRequest, "Requête invalide", "L'ID de l'artiste doit être un nombre valide.")
+		return
+	}
+
+	if len(parts) == 1 {
+		artist, err := apiClient.GetArtistComplete(r.Context(), id)
+		if err != nil {
+			writeJSONError(w, r, http.StatusNotFound, "Artiste non trouvé",
+				fmt.Sprintf("Aucun artiste avec l'ID %d.", id))
+			return
+		}
+		writeJSON(w, r, http.StatusOK, artist)
+		return
+	}
+
+	if len(parts) == 2 {
+		switch parts[1] {
+		case "locations":
+			locations, err := apiClient.GetLocationsByArtistID(r.Context(), id)
+			if err != nil {
+				writeJSONError(w, r, http.StatusNotFound, "Locations non trouvées",
+					fmt.Sprintf("Aucune location pour l'artiste %d.", id))
+				return
+			}
+			writeJSON(w, r, http.StatusOK, locations)
+			return
+		case "dates":
+			dates, err := apiClient.GetDatesByArtistID(r.Context(), id)
+			if err != nil {
+				writeJSONError(w, r, http.StatusNotFound, "Dates non trouvées",
+					fmt.Sprintf("Aucune date pour l'artiste %d.", id))
+				return
+			}
+			writeJSON(w, r, http.StatusOK, dates)
+			return
+		case "relations":
+			relation, err := apiClient.GetRelationByArtistID(r.Context(), id)
+			if err != nil {
+				writeJSONError(w, r, http.StatusNotFound, "Relations non trouvées",
+					fmt.Sprintf("Aucune relation pour l'artiste %d.", id))
+				return
+			}
+			writeJSON(w, r, http.StatusOK, relation)
+			return
+		}
+	}
+
+	writeJSONError(w, r, http.StatusNotFound, "Ressource non trouvée",
+		fmt.Sprintf("La ressource '%s' n'existe pas.", r.URL.Path))
+}
+
+// ============================================================================
+// RECHERCHE, FILTRES ET PAGINATION
+// ============================================================================
+
+const (
+	DefaultPageSize   = 20
+	MaxPageSize       = 100
+	AutocompleteLimit = 10
+)
+
+// diacriticsReplacer replace les caractères accentués courants par leur
+// équivalent non accentué, pour permettre une recherche insensible aux accents.
+var diacriticsReplacer = strings.NewReplacer(
+	"à", "a", "á", "a", "â", "a", "ä", "a", "ã", "a",
+	"ç", "c",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"î", "i", "ï", "i",
+	"ô", "o", "ö", "o", "õ", "o",
+	"ù", "u", "û", "u", "ü", "u",
+	"ñ", "n",
+)
+
+// normalizeText met en minuscules et retire les accents, pour comparer
+// deux chaînes indépendamment de la casse et des diacritiques.
+func normalizeText(s string) string {
+	return diacriticsReplacer.Replace(strings.ToLower(s))
+}
+
+// SearchParams regroupe les critères de recherche et de pagination extraits
+// des paramètres de requête (?q=, ?minYear=, ?page=, etc.).
+type SearchParams struct {
+	Query           string
+	MinCreationDate int
+	MaxCreationDate int
+	MinAlbumYear    int
+	MaxAlbumYear    int
+	Location        string
+	MinMembers      int
+	MaxMembers      int
+	Page            int
+	Size            int
+}
+
+// queryInt lit un paramètre de requête comme un entier, 0 si absent ou invalide.
+func queryInt(r *http.Request, key string) int {
+	value, err := strconv.Atoi(r.URL.Query().Get(key))
+	if err != nil {
+		return 0
+	}
+	return value
+}
+
+// parseSearchParams construit les SearchParams à partir de la requête HTTP.
+func parseSearchParams(r *http.Request) SearchParams {
+	return SearchParams{
+		Query:           r.URL.Query().Get("q"),
+		MinCreationDate: queryInt(r, "minYear"),
+		MaxCreationDate: queryInt(r, "maxYear"),
+		MinAlbumYear:    queryInt(r, "minAlbumYear"),
+		MaxAlbumYear:    queryInt(r, "maxAlbumYear"),
+		Location:        r.URL.Query().Get("location"),
+		MinMembers:      queryInt(r, "minMembers"),
+		MaxMembers:      queryInt(r, "maxMembers"),
+		Page:            queryInt(r, "page"),
+		Size:            queryInt(r, "size"),
+	}
+}
+
+// searchableArtist précalcule, pour un artiste, les champs normalisés utilisés
+// par les filtres afin d'éviter de refaire le travail de normalisation à
+// chaque comparaison.
+type searchableArtist struct {
+	artist              ArtistComplete
+	normalizedName      string
+	normalizedMembers   []string
+	normalizedLocations []string
+	albumYear           int
+}
+
+// albumYearOf extrait l'année depuis une date d'album au format "DD-MM-YYYY".
+func albumYearOf(firstAlbum string) int {
+	parts := strings.Split(firstAlbum, "-")
+	year, err := strconv.Atoi(parts[len(parts)-1])
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// buildSearchableArtists indexe les artistes en mémoire: noms, membres et
+// locations de concert normalisés, prêts pour la recherche et l'autocomplétion.
+func buildSearchableArtists(artists []ArtistComplete) []searchableArtist {
+	result := make([]searchableArtist, len(artists))
+	for i, artist := range artists {
+		members := make([]string, len(artist.Members))
+		for j, member := range artist.Members {
+			members[j] = normalizeText(member)
+		}
+
+		locationSet := make(map[string]bool)
+		for location := range artist.DatesLocations {
+			locationSet[normalizeText(location)] = true
+		}
+		for _, location := range artist.LocationsList {
+			locationSet[normalizeText(location)] = true
+		}
+		locations := make([]string, 0, len(locationSet))
+		for location := range locationSet {
+			locations = append(locations, location)
+		}
+
+		result[i] = searchableArtist{
+			artist:              artist,
+			normalizedName:      normalizeText(artist.Name),
+			normalizedMembers:   members,
+			normalizedLocations: locations,
+			albumYear:           albumYearOf(artist.FirstAlbum),
+		}
+	}
+	return result
+}
+
+// matchesSearchParams applique les filtres de recherche à un artiste indexé.
+func matchesSearchParams(sa searchableArtist, p SearchParams) bool {
+	if query := normalizeText(p.Query); query != "" {
+		found := strings.Contains(sa.normalizedName, query)
+		for _, member := range sa.normalizedMembers {
+			if strings.Contains(member, query) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if p.MinCreationDate > 0 && sa.artist.CreationDate < p.MinCreationDate {
+		return false
+	}
+	if p.MaxCreationDate > 0 && sa.artist.CreationDate > p.MaxCreationDate {
+		return false
+	}
+	if p.MinAlbumYear > 0 && sa.albumYear < p.MinAlbumYear {
+		return false
+	}
+	if p.MaxAlbumYear > 0 && sa.albumYear > p.MaxAlbumYear {
+		return false
+	}
+
+	if location := normalizeText(p.Location); location != "" {
+		found := false
+		for _, l := range sa.normalizedLocations {
+			if strings.Contains(l, location) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if p.MinMembers > 0 && len(sa.artist.Members) < p.MinMembers {
+		return false
+	}
+	if p.MaxMembers > 0 && len(sa.artist.Members) > p.MaxMembers {
+		return false
+	}
+
+	return true
+}
+
+// filterArtists renvoie les artistes correspondant aux critères de recherche.
+//
+// Note: l'index est reconstruit à chaque appel (via buildSearchableArtists)
+// plutôt que maintenu en mémoire et invalidé au rafraîchissement du cache de
+// l'API. C'est un choix délibérément simple tant que GetAllArtistsComplete
+// reste la seule source de vérité et que son propre cache borne déjà le
+// volume de données reconstruit par requête; à revoir si le nombre
+// d'artistes ou la fréquence des recherches grossit sensiblement.
+func filterArtists(artists []ArtistComplete, p SearchParams) []ArtistComplete {
+	searchable := buildSearchableArtists(artists)
+	matched := make([]ArtistComplete, 0, len(artists))
+	for _, sa := range searchable {
+		if matchesSearchParams(sa, p) {
+			matched = append(matched, sa.artist)
+		}
+	}
+	return matched
+}
+
+// paginate découpe une liste d'artistes selon page/size et renvoie, en plus
+// du total avant pagination, la page et la taille effectivement appliquées
+// (après clamp) afin que les appelants n'aient pas à reproduire ce clamp
+// séparément pour leurs métadonnées de réponse.
+func paginate(artists []ArtistComplete, page, size int) (items []ArtistComplete, total, effectivePage, effectiveSize int) {
+	total = len(artists)
+
+	if page < 1 {
+		page = 1
+	}
+	if size < 1 {
+		size = DefaultPageSize
+	}
+	if size > MaxPageSize {
+		size = MaxPageSize
+	}
+
+	start := (page - 1) * size
+	if start >= total {
+		return []ArtistComplete{}, total, page, size
+	}
+
+	end := start + size
+	if end > total {
+		end = total
+	}
+
+	return artists[start:end], total, page, size
+}
+
+// SearchResponse est le corps JSON renvoyé par /search.
+type SearchResponse struct {
+	Artists []ArtistComplete `json:"artists"`
+	Total   int              `json:"total"`
+	Page    int              `json:"page"`
+	Size    int              `json:"size"`
+}
+
+// searchHandler gère GET/HEAD /search: recherche plein texte et filtres sur
+// l'ensemble des artistes, avec pagination.
+func searchHandler(w http.ResponseWriter, r *http.Request) {
+	if !apiMethodAllowed(w, r) {
+		return
+	}
+
+	artists, err := apiClient.GetAllArtistsComplete(r.Context())
+	if err != nil {
+		writeJSONError(w, r, http.StatusInternalServerError, "Erreur interne du serveur",
+			"Impossible de récupérer la liste des artistes.")
+		return
+	}
+
+	params := parseSearchParams(r)
+	filtered := filterArtists(artists, params)
+	page, total, effectivePage, effectiveSize := paginate(filtered, params.Page, params.Size)
+
+	writeJSON(w, r, http.StatusOK, SearchResponse{
+		Artists: page,
+		Total:   total,
+		Page:    effectivePage,
+		Size:    effectiveSize,
+	})
+}
+
+// SearchPageData regroupe les données envoyées au template de la page de
+// recherche avec résultats filtrés.
+type SearchPageData struct {
+	Artists  []ArtistComplete
+	Total    int
+	Page     int
+	Size     int
+	Query    string
+	Location string
+}
+
+// searchPageHandler gère GET/HEAD /recherche: page HTML avec formulaire de
+// recherche/filtres et résultats paginés, en réutilisant les mêmes filtres
+// que l'API JSON /search.
+func searchPageHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		methodNotAllowedHandler(w, r)
+		return
+	}
+
+	artists, err := apiClient.GetAllArtistsComplete(r.Context())
+	if err != nil {
+		internalServerErrorHandler(w, r, err)
+		return
+	}
+
+	params := parseSearchParams(r)
+	filtered := filterArtists(artists, params)
+	page, total, effectivePage, effectiveSize := paginate(filtered, params.Page, params.Size)
+
+	data := SearchPageData{
+		Artists:  page,
+		Total:    total,
+		Page:     effectivePage,
+		Size:     effectiveSize,
+		Query:    params.Query,
+		Location: params.Location,
+	}
+
+	if err := templates.ExecuteTemplate(w, "recherche.html", data); err != nil {
+		internalServerErrorHandler(w, r, err)
+		return
+	}
+}
+
+// autocompleteHandler gère GET/HEAD /autocomplete?q=...: suggestions en
+// JSON sur les noms d'artistes, membres et locations de concert.
+func autocompleteHandler(w http.ResponseWriter, r *http.Request) {
+	if !apiMethodAllowed(w, r) {
+		return
+	}
+
+	query := normalizeText(r.URL.Query().Get("q"))
+	if query == "" {
+		writeJSON(w, r, http.StatusOK, []string{})
+		return
+	}
+
+	artists, err := apiClient.GetAllArtistsComplete(r.Context())
+	if err != nil {
+		writeJSONError(w, r, http.StatusInternalServerError, "Erreur interne du serveur",
+			"Impossible de récupérer la liste des artistes.")
 		return
 	}
+
+	suggestions := make(map[string]bool)
+	for _, artist := range artists {
+		if strings.Contains(normalizeText(artist.Name), query) {
+			suggestions[artist.Name] = true
+		}
+		for _, member := range artist.Members {
+			if strings.Contains(normalizeText(member), query) {
+				suggestions[member] = true
+			}
+		}
+		for location := range artist.DatesLocations {
+			if strings.Contains(normalizeText(location), query) {
+				suggestions[location] = true
+			}
+		}
+	}
+
+	result := make([]string, 0, len(suggestions))
+	for suggestion := range suggestions {
+		result = append(result, suggestion)
+	}
+	sort.Strings(result)
+
+	if len(result) > AutocompleteLimit {
+		result = result[:AutocompleteLimit]
+	}
+
+	writeJSON(w, r, http.StatusOK, result)
+}
+
+// MapPageData regroupe les données envoyées au template Leaflet de la carte.
+type MapPageData struct {
+	Artist  *ArtistComplete
+	Artists []ArtistComplete
+}
+
+// artistMapHandler gère GET/HEAD /artist/{id}/map: carte des lieux de
+// concert d'un artiste donné.
+func artistMapHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		methodNotAllowedHandler(w, r)
+		return
+	}
+
+	rest := strings.TrimPrefix(r.URL.Path, "/artist/")
+	parts := strings.Split(strings.Trim(rest, "/"), "/")
+	if len(parts) != 2 || parts[1] != "map" {
+		notFoundHandler(w, r)
+		return
+	}
+
+	id, err := strconv.Atoi(parts[0])
+	if err != nil || id < 1 {
+		badRequestHandler(w, r, "L'ID de l'artiste doit être un nombre valide")
+		return
+	}
+
+	artist, err := apiClient.GetArtistComplete(r.Context(), id)
+	if err != nil {
+		loggerFromContext(r.Context()).Warn("artiste introuvable", "artistId", id, "error", err)
+		notFoundHandler(w, r)
+		return
+	}
+
+	if err := templates.ExecuteTemplate(w, "map.html", MapPageData{Artist: artist}); err != nil {
+		internalServerErrorHandler(w, r, err)
+		return
+	}
+}
+
+// mapAllHandler gère GET/HEAD /map: carte regroupant les lieux de concert
+// de tous les artistes.
+func mapAllHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		methodNotAllowedHandler(w, r)
+		return
+	}
+
+	base, err := apiClient.GetArtists(r.Context())
+	if err != nil {
+		internalServerErrorHandler(w, r, err)
+		return
+	}
+
+	artists := make([]ArtistComplete, 0, len(base))
+	for _, artist := range base {
+		complete, err := apiClient.GetArtistComplete(r.Context(), artist.ID)
+		if err != nil {
+			loggerFromContext(r.Context()).Warn("récupération de l'artiste pour la carte impossible", "artistId", artist.ID, "error", err)
+			continue
+		}
+		artists = append(artists, *complete)
+	}
+
+	if err := templates.ExecuteTemplate(w, "map.html", MapPageData{Artists: artists}); err != nil {
+		internalServerErrorHandler(w, r, err)
+		return
+	}
+}
+
+// MetricsResponse est le corps JSON renvoyé par /metrics.
+type MetricsResponse struct {
+	Hits          int64 `json:"hits"`
+	Misses        int64 `json:"misses"`
+	Revalidations int64 `json:"revalidations"`
+	BytesSaved    int64 `json:"bytesSaved"`
+}
+
+// metricsHandler gère GET/HEAD /metrics: compteurs d'activité du cache
+// L1/L2 de l'APIClient.
+func metricsHandler(w http.ResponseWriter, r *http.Request) {
+	if !apiMethodAllowed(w, r) {
+		return
+	}
+
+	writeJSON(w, r, http.StatusOK, MetricsResponse{
+		Hits:          atomic.LoadInt64(&apiClient.metrics.Hits),
+		Misses:        atomic.LoadInt64(&apiClient.metrics.Misses),
+		Revalidations: atomic.LoadInt64(&apiClient.metrics.Revalidations),
+		BytesSaved:    atomic.LoadInt64(&apiClient.metrics.BytesSaved),
+	})
 }
 
 // Wrapper pour le FileServer avec gestion d'erreurs
@@ -552,7 +1935,7 @@ func safeFileServer(dir string, prefix string) http.Handler {
 			return
 		}
 		if err != nil {
-			internalServerErrorHandler(w, err)
+			internalServerErrorHandler(w, r, err)
 			return
 		}
 
@@ -562,15 +1945,17 @@ func safeFileServer(dir string, prefix string) http.Handler {
 }
 
 func main() {
-	// Configuration du logger
-	log.SetFlags(log.Ldate | log.Ltime | log.Lshortfile)
-	log.Println("Démarrage du serveur Groupie-Tracker...")
+	cfg := loadConfig()
+	logger = newLogger(cfg)
+	logger.Info("démarrage du serveur Groupie-Tracker...", "port", cfg.Port, "apiBaseURL", cfg.APIBaseURL)
+
+	setup(cfg)
 
 	// Création du multiplexeur de routes
 	mux := http.NewServeMux()
 
 	// Routes pour les fichiers statiques avec gestion d'erreurs
-	mux.Handle("/static/", safeFileServer("./static", "/static/"))
+	mux.Handle("/static/", safeFileServer(cfg.StaticDir, "/static/"))
 	mux.Handle("/image/", safeFileServer("./image", "/image/"))
 
 	// Route principale
@@ -579,12 +1964,28 @@ func main() {
 	// Route pour afficher un artiste spécifique
 	mux.HandleFunc("/artist", artistHandler)
 
+	// Routes de l'API REST JSON (v1), servies en parallèle des pages HTML
+	mux.HandleFunc(APIPrefix+"/artists", apiArtistsListHandler)
+	mux.HandleFunc(APIPrefix+"/artists/", apiArtistSubHandler)
+
+	// Routes de recherche, filtres et pagination
+	mux.HandleFunc("/search", searchHandler)
+	mux.HandleFunc("/recherche", searchPageHandler)
+	mux.HandleFunc("/autocomplete", autocompleteHandler)
+
+	// Routes de la carte des lieux de concert
+	mux.HandleFunc("/map", mapAllHandler)
+	mux.HandleFunc("/artist/", artistMapHandler)
+
+	// Métriques du cache L1/L2
+	mux.HandleFunc("/metrics", metricsHandler)
+
 	// Application du middleware de logging
 	handler := loggingMiddleware(mux)
 
 	// Configuration du serveur avec timeouts
 	server := &http.Server{
-		Addr:         ":8080",
+		Addr:         ":" + cfg.Port,
 		Handler:      handler,
 		ReadTimeout:  15 * time.Second,
 		WriteTimeout: 15 * time.Second,
@@ -593,11 +1994,40 @@ func main() {
 
 	fmt.Println("╔════════════════════════════════════════════╗")
 	fmt.Println("║   Serveur Groupie-Tracker démarré !        ║")
-	fmt.Println("║   URL: http://localhost:8080               ║")
+	fmt.Printf("║   URL: http://localhost:%-20s║\n", cfg.Port)
 	fmt.Println("╚════════════════════════════════════════════╝")
 
-	// Démarrer le serveur avec gestion d'erreur
-	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-		log.Fatalf("Erreur fatale du serveur: %v", err)
+	// Démarrer le serveur dans une goroutine pour pouvoir écouter les
+	// signaux d'arrêt sans bloquer le processus principal
+	serverErrors := make(chan error, 1)
+	go func() {
+		serverErrors <- server.ListenAndServe()
+	}()
+
+	// Attendre un signal d'interruption (Ctrl+C) ou d'arrêt (docker stop, etc.)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	select {
+	case err := <-serverErrors:
+		if err != nil && err != http.ErrServerClosed {
+			logger.Error("erreur fatale du serveur", "error", err)
+			os.Exit(1)
+		}
+		return
+	case <-ctx.Done():
+		stop()
+		logger.Info("signal d'arrêt reçu, extinction du serveur en cours...")
 	}
+
+	// Laisser le temps aux requêtes en vol de se terminer avant de couper
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("erreur lors de l'extinction du serveur", "error", err)
+		os.Exit(1)
+	}
+
+	logger.Info("serveur arrêté proprement")
 }
@@ -0,0 +1,134 @@
+package main
+
+import "testing"
+
+func TestAlbumYearOf(t *testing.T) {
+	cases := []struct {
+		firstAlbum string
+		want       int
+	}{
+		{"10-07-1969", 1969},
+		{"2023", 2023},
+		{"", 0},
+		{"not-a-date", 0},
+	}
+
+	for _, c := range cases {
+		if got := albumYearOf(c.firstAlbum); got != c.want {
+			t.Errorf("albumYearOf(%q) = %d, want %d", c.firstAlbum, got, c.want)
+		}
+	}
+}
+
+func TestMatchesSearchParams(t *testing.T) {
+	artists := []ArtistComplete{
+		{
+			Artist: Artist{
+				ID:           1,
+				Name:         "Pink Floyd",
+				Members:      []string{"David Gilmour", "Roger Waters"},
+				CreationDate: 1965,
+				FirstAlbum:   "05-08-1967",
+			},
+			DatesLocations: map[string][]string{"london-uk": nil},
+		},
+		{
+			Artist: Artist{
+				ID:           2,
+				Name:         "Queen",
+				Members:      []string{"Freddie Mercury", "Brian May", "Roger Taylor", "John Deacon"},
+				CreationDate: 1970,
+				FirstAlbum:   "13-07-1973",
+			},
+			DatesLocations: map[string][]string{"los_angeles-usa": nil},
+		},
+	}
+	searchable := buildSearchableArtists(artists)
+
+	cases := []struct {
+		name string
+		sa   searchableArtist
+		p    SearchParams
+		want bool
+	}{
+		{"name query matches", searchable[0], SearchParams{Query: "floyd"}, true},
+		{"member query matches", searchable[0], SearchParams{Query: "gilmour"}, true},
+		{"query does not match", searchable[0], SearchParams{Query: "zeppelin"}, false},
+		{"creation date out of range", searchable[0], SearchParams{MinCreationDate: 1968}, false},
+		{"album year in range", searchable[1], SearchParams{MinAlbumYear: 1970, MaxAlbumYear: 1975}, true},
+		{"location substring matches", searchable[1], SearchParams{Location: "los_angeles"}, true},
+		{"location does not match", searchable[0], SearchParams{Location: "paris"}, false},
+		{"member count filter excludes", searchable[0], SearchParams{MinMembers: 3}, false},
+		{"member count filter includes", searchable[1], SearchParams{MinMembers: 3}, true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := matchesSearchParams(c.sa, c.p); got != c.want {
+				t.Errorf("matchesSearchParams(%q, %+v) = %v, want %v", c.sa.artist.Name, c.p, got, c.want)
+			}
+		})
+	}
+}
+
+func TestFilterArtists(t *testing.T) {
+	artists := []ArtistComplete{
+		{Artist: Artist{ID: 1, Name: "Pink Floyd", CreationDate: 1965}},
+		{Artist: Artist{ID: 2, Name: "Queen", CreationDate: 1970}},
+		{Artist: Artist{ID: 3, Name: "Pink Martini", CreationDate: 1994}},
+	}
+
+	filtered := filterArtists(artists, SearchParams{Query: "pink"})
+	if len(filtered) != 2 {
+		t.Fatalf("filterArtists(%q) returned %d artists, want 2", "pink", len(filtered))
+	}
+}
+
+func TestPaginateClampsSizeAndReportsEffectiveValues(t *testing.T) {
+	artists := make([]ArtistComplete, 250)
+	for i := range artists {
+		artists[i] = ArtistComplete{Artist: Artist{ID: i + 1}}
+	}
+
+	page, total, effectivePage, effectiveSize := paginate(artists, 1, 1000)
+	if total != 250 {
+		t.Fatalf("total = %d, want 250", total)
+	}
+	if effectiveSize != MaxPageSize {
+		t.Errorf("effectiveSize = %d, want %d", effectiveSize, MaxPageSize)
+	}
+	if len(page) != MaxPageSize {
+		t.Errorf("len(page) = %d, want %d", len(page), MaxPageSize)
+	}
+	if effectivePage != 1 {
+		t.Errorf("effectivePage = %d, want 1", effectivePage)
+	}
+}
+
+func TestPaginateDefaultsSizeWhenMissing(t *testing.T) {
+	artists := make([]ArtistComplete, 5)
+	page, total, effectivePage, effectiveSize := paginate(artists, 0, 0)
+	if effectivePage != 1 {
+		t.Errorf("effectivePage = %d, want 1", effectivePage)
+	}
+	if effectiveSize != DefaultPageSize {
+		t.Errorf("effectiveSize = %d, want %d", effectiveSize, DefaultPageSize)
+	}
+	if total != 5 {
+		t.Errorf("total = %d, want 5", total)
+	}
+	if len(page) != 5 {
+		t.Errorf("len(page) = %d, want 5", len(page))
+	}
+}
+
+func TestPaginateBeyondLastPageReturnsEmpty(t *testing.T) {
+	artists := make([]ArtistComplete, 10)
+	page, total, _, _ := paginate(artists, 5, 10)
+	if len(page) != 0 {
+		t.Errorf("len(page) = %d, want 0", len(page))
+	}
+	if total != 10 {
+		t.Errorf("total = %d, want 10", total)
+	}
+}